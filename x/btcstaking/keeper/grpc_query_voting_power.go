@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/babylonchain/babylon/x/btcstaking/types"
+)
+
+// HistoricalVotingPowerTable implements the Query/HistoricalVotingPowerTable
+// gRPC method. It returns the BTC validator voting power table recorded at
+// the requested Babylon height, or ErrVotingPowerTablePruned if that
+// snapshot has already been pruned per the VotingPowerRetentionBlocks param.
+func (k Keeper) HistoricalVotingPowerTable(c context.Context, req *types.QueryHistoricalVotingPowerTableRequest) (*types.QueryHistoricalVotingPowerTableResponse, error) {
+	powerTable := k.GetVotingPowerTable(c, req.Height)
+	if powerTable != nil {
+		return &types.QueryHistoricalVotingPowerTableResponse{VotingPowerTable: powerTable}, nil
+	}
+
+	activatedHeight, err := k.GetBTCStakingActivatedHeight(c)
+	if err != nil {
+		return nil, err
+	}
+	if req.Height >= activatedHeight {
+		// the height is within the activated range but has no snapshot left,
+		// so it must have been pruned
+		return nil, types.ErrVotingPowerTablePruned
+	}
+
+	return nil, types.ErrBTCStakingNotActivated
+}
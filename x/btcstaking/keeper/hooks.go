@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/babylonchain/babylon/x/btcstaking/types"
+)
+
+var _ types.BTCStakingHooks = Keeper{}
+
+// AfterCovenantQuorumReached queues the delegation to activate once the BTC
+// tip reaches activationBTCHeight. Covenant signing can finish after the BTC
+// tip has already passed activationBTCHeight (which only depends on
+// confirmation depth, not on when quorum is reached); queueing into a height
+// bucket ProcessBTCTipUpdate has already scanned past would mean the
+// delegation's power never enters the cache, so in that case it is
+// activated immediately instead.
+func (k Keeper) AfterCovenantQuorumReached(ctx context.Context, valBTCPK, delBTCPK, stakingTxHash []byte, activationBTCHeight, expiryBTCHeight uint64, power uint64) error {
+	currentBTCTip, err := k.GetCurrentBTCHeight(ctx)
+	if err != nil {
+		return err
+	}
+
+	if activationBTCHeight <= currentBTCTip {
+		return k.AfterBTCDelegationActivated(ctx, valBTCPK, delBTCPK, stakingTxHash, expiryBTCHeight, power)
+	}
+
+	k.EnqueuePendingActivation(ctx, activationBTCHeight, valBTCPK, delBTCPK, stakingTxHash, expiryBTCHeight, power)
+	return nil
+}
+
+// AfterBTCDelegationActivated adds the delegation's power to its validator's
+// cached tally and queues the delegation for removal at its expiry height.
+func (k Keeper) AfterBTCDelegationActivated(ctx context.Context, valBTCPK, delBTCPK, stakingTxHash []byte, expiryBTCHeight uint64, power uint64) error {
+	k.addValidatorPowerCache(ctx, valBTCPK, power)
+	k.EnqueuePendingExpiry(ctx, expiryBTCHeight, valBTCPK, delBTCPK, stakingTxHash, power)
+	return nil
+}
+
+// AfterBTCDelegationExpired removes the delegation's power from its
+// validator's cached tally once its timelock has expired.
+func (k Keeper) AfterBTCDelegationExpired(ctx context.Context, valBTCPK, delBTCPK, stakingTxHash []byte, power uint64) error {
+	k.subValidatorPowerCache(ctx, valBTCPK, power)
+	return nil
+}
+
+// AfterBTCDelegationUnbonded removes the delegation's power from its
+// validator's cached tally and cancels its queued expiry at expiryBTCHeight,
+// since it no longer needs to be removed again when the timelock would have
+// expired: without this, processPendingExpiryAt would later find the stale
+// PendingExpiry entry and subtract the same delegation's power a second time.
+func (k Keeper) AfterBTCDelegationUnbonded(ctx context.Context, valBTCPK, delBTCPK, stakingTxHash []byte, expiryBTCHeight uint64, power uint64) error {
+	k.subValidatorPowerCache(ctx, valBTCPK, power)
+	k.CancelPendingExpiry(ctx, expiryBTCHeight, valBTCPK, delBTCPK, stakingTxHash)
+	return nil
+}
+
+// AfterBTCValidatorSlashed drops the validator's entire cached voting power.
+func (k Keeper) AfterBTCValidatorSlashed(ctx context.Context, valBTCPK []byte) error {
+	k.resetValidatorPowerCache(ctx, valBTCPK)
+	return nil
+}
@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/babylonchain/babylon/x/btcstaking/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RegisterInvariants registers all btcstaking invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "validator-power-cache",
+		ValidatorPowerCacheInvariant(k))
+}
+
+// ValidatorPowerCacheInvariant checks that the incrementally maintained
+// ValidatorPowerCache matches a full recomputation from delegation state,
+// analogous to cosmos-sdk staking's power-index invariants. A mismatch means
+// a BTCStakingHooks call was missed or applied incorrectly somewhere.
+func ValidatorPowerCacheInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		msg, broken := validatorPowerCacheInvariant(ctx, k)
+		return sdk.FormatInvariant(types.ModuleName, "validator-power-cache", msg), broken
+	}
+}
+
+func validatorPowerCacheInvariant(ctx context.Context, k Keeper) (string, bool) {
+	expected, err := k.computeValidatorPowerFullScan(ctx)
+	if err != nil {
+		return fmt.Sprintf("failed to recompute voting power from scratch: %s", err), true
+	}
+
+	actual := map[string]uint64{}
+	k.IterateValidatorPowerCache(ctx, func(valBTCPKBytes []byte, power uint64) bool {
+		actual[fmt.Sprintf("%x", valBTCPKBytes)] = power
+		return true
+	})
+
+	mismatches := []string{}
+	for pk, expectedPower := range expected {
+		if actualPower := actual[pk]; actualPower != expectedPower {
+			mismatches = append(mismatches, fmt.Sprintf("validator %s: cached %d, recomputed %d", pk, actualPower, expectedPower))
+		}
+	}
+	for pk, actualPower := range actual {
+		if _, ok := expected[pk]; !ok && actualPower != 0 {
+			mismatches = append(mismatches, fmt.Sprintf("validator %s: cached %d, recomputed 0", pk, actualPower))
+		}
+	}
+
+	if len(mismatches) == 0 {
+		return "", false
+	}
+	return fmt.Sprintf("found %d validator power cache mismatches:\n%s", len(mismatches), mismatches), true
+}
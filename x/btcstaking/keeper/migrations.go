@@ -0,0 +1,24 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Migrator is a wrapper for the btcstaking keeper exposing in-place store
+// migrations, following the standard cosmos-sdk module migration pattern.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator for the btcstaking module
+func NewMigrator(k Keeper) Migrator {
+	return Migrator{keeper: k}
+}
+
+// Migrate2to3 rebuilds ValidatorPowerCache from existing BTC delegations.
+// It is required when upgrading from a version of the chain that recomputed
+// the voting power table via a full scan every block to one that maintains
+// the cache incrementally via BTCStakingHooks.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	return m.keeper.RebuildValidatorPowerCache(ctx)
+}
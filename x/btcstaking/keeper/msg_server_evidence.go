@@ -0,0 +1,160 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/babylonchain/babylon/crypto/bls12381"
+	"github.com/babylonchain/babylon/x/btcstaking/types"
+)
+
+// SubmitBTCValidatorEvidence handles MsgSubmitBTCValidatorEvidence: it
+// verifies the submitted double-sign proof against the accused validator's
+// recorded key, checks that the validator has voting power (at the
+// equivocation's height for BTC-key double signs, at the current height for
+// epoch-scoped BLS double signs), and slashes it if both hold.
+func (k Keeper) SubmitBTCValidatorEvidence(ctx context.Context, msg *types.MsgSubmitBTCValidatorEvidence) (*types.MsgSubmitBTCValidatorEvidenceResponse, error) {
+	maxAge := k.GetParams(ctx).MaxEvidenceAge
+	currentHeight := uint64(sdk.UnwrapSDKContext(ctx).BlockHeight())
+
+	if msg.DoubleSign != nil {
+		return k.handleDoubleSignEvidence(ctx, msg.DoubleSign, currentHeight, maxAge)
+	}
+	return k.handleBlsDoubleSignEvidence(ctx, msg.BlsDoubleSign, currentHeight, maxAge)
+}
+
+func (k Keeper) handleDoubleSignEvidence(ctx context.Context, ev *types.DoubleSignEvidence, currentHeight, maxAge uint64) (*types.MsgSubmitBTCValidatorEvidenceResponse, error) {
+	if maxAge > 0 && currentHeight > ev.Height && currentHeight-ev.Height > maxAge {
+		return nil, types.ErrEvidenceTooOld
+	}
+
+	valBTCPKBytes := ev.ValBtcPk.MustMarshal()
+	if _, err := k.GetBTCValidator(ctx, valBTCPKBytes); err != nil {
+		return nil, err
+	}
+
+	btcPK, err := ev.ValBtcPk.ToBTCPK()
+	if err != nil {
+		return nil, err
+	}
+	if !verifyBIP340Sig(btcPK, ev.BlockHash1, ev.Sig1) || !verifyBIP340Sig(btcPK, ev.BlockHash2, ev.Sig2) {
+		return nil, types.ErrInvalidEvidence.Wrap("BTC signature verification failed")
+	}
+
+	if err := k.checkHadVotingPowerAt(ctx, valBTCPKBytes, ev.Height); err != nil {
+		return nil, err
+	}
+
+	if err := k.SlashBTCValidator(ctx, valBTCPKBytes, "double sign of Babylon blocks"); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSubmitBTCValidatorEvidenceResponse{}, nil
+}
+
+func (k Keeper) handleBlsDoubleSignEvidence(ctx context.Context, ev *types.BlsDoubleSignEvidence, currentHeight, maxAge uint64) (*types.MsgSubmitBTCValidatorEvidenceResponse, error) {
+	valBTCPKBytes := ev.ValBtcPk.MustMarshal()
+	btcVal, err := k.GetBTCValidator(ctx, valBTCPKBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	if !bls12381.Verify(btcVal.BlsPubKey, checkpointSignBytesForEpoch(ev.Epoch, ev.BlockHash1), ev.Sig1) ||
+		!bls12381.Verify(btcVal.BlsPubKey, checkpointSignBytesForEpoch(ev.Epoch, ev.BlockHash2), ev.Sig2) {
+		return nil, types.ErrInvalidEvidence.Wrap("BLS signature verification failed")
+	}
+
+	// BLS evidence is scoped to an epoch rather than a Babylon height, so
+	// MaxEvidenceAge is enforced against the height this validator's evidence
+	// for the epoch was first referenced at, cached the first time it is
+	// seen. This must run only once the evidence has actually verified:
+	// recording it beforehand would let an unauthenticated, bogus submission
+	// for an epoch lock in an artificially-early age-clock start that could
+	// wrongly reject a later, genuinely valid submission.
+	referenceHeight := k.getOrSetEpochReferenceHeight(ctx, ev.Epoch, valBTCPKBytes, currentHeight)
+	if maxAge > 0 && currentHeight > referenceHeight && currentHeight-referenceHeight > maxAge {
+		return nil, types.ErrEvidenceTooOld
+	}
+
+	// the equivocation is epoch-scoped rather than height-scoped, so the
+	// current voting power is what determines whether slashing the
+	// validator still has any effect
+	if k.GetVotingPower(ctx, valBTCPKBytes, currentHeight) == 0 {
+		return nil, types.ErrInvalidEvidence.Wrap("validator currently has no voting power")
+	}
+
+	if err := k.SlashBTCValidator(ctx, valBTCPKBytes, "double sign of BLS checkpoint"); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSubmitBTCValidatorEvidenceResponse{}, nil
+}
+
+// checkHadVotingPowerAt reports whether a BTC validator had non-zero voting
+// power at height, distinguishing "genuinely had none" from "the snapshot at
+// that height has since been pruned" (per VotingPowerRetentionBlocks):
+// conflating the two would let evidence still within MaxEvidenceAge, but
+// referencing an already-pruned height, be silently rejected as invalid
+// rather than surfaced as the distinct, ambiguous case it is.
+func (k Keeper) checkHadVotingPowerAt(ctx context.Context, valBTCPKBytes []byte, height uint64) error {
+	if k.GetVotingPower(ctx, valBTCPKBytes, height) > 0 {
+		return nil
+	}
+
+	if !k.HasVotingPowerTable(ctx, height) {
+		if activatedHeight, err := k.GetBTCStakingActivatedHeight(ctx); err == nil && height >= activatedHeight {
+			return types.ErrVotingPowerTablePruned
+		}
+	}
+
+	return types.ErrInvalidEvidence.Wrapf("validator had no voting power at height %d", height)
+}
+
+// verifyBIP340Sig checks a BIP340 (Schnorr) signature by a BTC validator's
+// recorded BTC public key over msg, hashed with sha256d as is standard for
+// Bitcoin-style message signing
+func verifyBIP340Sig(pk *btcec.PublicKey, msg, sigBytes []byte) bool {
+	sig, err := schnorr.ParseSignature(sigBytes)
+	if err != nil {
+		return false
+	}
+	return sig.Verify(chainhash.DoubleHashB(msg), pk)
+}
+
+// getOrSetEpochReferenceHeight returns the Babylon height cached under
+// EpochReferenceHeightKey for (epoch, valBTCPK), recording currentHeight as
+// that reference the first time this validator's evidence for the epoch is
+// seen. Scoping the key per validator, not just per epoch, keeps one
+// validator's evidence timeline from affecting another's age-out deadline.
+func (k Keeper) getOrSetEpochReferenceHeight(ctx context.Context, epoch uint64, valBTCPKBytes []byte, currentHeight uint64) uint64 {
+	store := k.epochReferenceHeightStore(ctx)
+	key := append(sdk.Uint64ToBigEndian(epoch), valBTCPKBytes...)
+	if bz := store.Get(key); len(bz) > 0 {
+		return sdk.BigEndianToUint64(bz)
+	}
+	store.Set(key, sdk.Uint64ToBigEndian(currentHeight))
+	return currentHeight
+}
+
+func (k Keeper) epochReferenceHeightStore(ctx context.Context) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(storeAdapter, types.EpochReferenceHeightKey)
+}
+
+// checkpointSignBytesForEpoch mirrors x/checkpointing's internal
+// checkpointSignBytes helper: the canonical bytes a validator's BLS
+// signature over an epoch checkpoint is computed over
+func checkpointSignBytesForEpoch(epoch uint64, blockHash []byte) []byte {
+	bz := make([]byte, 0, 8+len(blockHash))
+	bz = append(bz, sdk.Uint64ToBigEndian(epoch)...)
+	bz = append(bz, blockHash...)
+	return bz
+}
@@ -0,0 +1,157 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/babylonchain/babylon/x/btcstaking/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// pendingEntryKey builds the suffix that uniquely identifies a BTC
+// delegation within a PendingActivation/PendingExpiry height bucket
+func pendingEntryKey(valBTCPK, delBTCPK, stakingTxHash []byte) []byte {
+	key := make([]byte, 0, len(valBTCPK)+len(delBTCPK)+len(stakingTxHash))
+	key = append(key, valBTCPK...)
+	key = append(key, delBTCPK...)
+	key = append(key, stakingTxHash...)
+	return key
+}
+
+func (k Keeper) pendingActivationStore(ctx context.Context, btcHeight uint64) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	pendingStore := prefix.NewStore(storeAdapter, types.PendingActivationKey)
+	return prefix.NewStore(pendingStore, sdk.Uint64ToBigEndian(btcHeight))
+}
+
+func (k Keeper) pendingExpiryStore(ctx context.Context, btcHeight uint64) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	pendingStore := prefix.NewStore(storeAdapter, types.PendingExpiryKey)
+	return prefix.NewStore(pendingStore, sdk.Uint64ToBigEndian(btcHeight))
+}
+
+// EnqueuePendingActivation queues a BTC delegation that has reached covenant
+// quorum to activate once the BTC tip reaches activationBTCHeight. It also
+// records expiryBTCHeight so the delegation can be queued for removal as
+// soon as it activates.
+func (k Keeper) EnqueuePendingActivation(ctx context.Context, activationBTCHeight uint64, valBTCPK, delBTCPK, stakingTxHash []byte, expiryBTCHeight uint64, power uint64) {
+	value := make([]byte, 16)
+	copy(value[0:8], sdk.Uint64ToBigEndian(power))
+	copy(value[8:16], sdk.Uint64ToBigEndian(expiryBTCHeight))
+	k.pendingActivationStore(ctx, activationBTCHeight).Set(pendingEntryKey(valBTCPK, delBTCPK, stakingTxHash), value)
+}
+
+// EnqueuePendingExpiry queues an active BTC delegation for removal once the
+// BTC tip reaches expiryBTCHeight
+func (k Keeper) EnqueuePendingExpiry(ctx context.Context, expiryBTCHeight uint64, valBTCPK, delBTCPK, stakingTxHash []byte, power uint64) {
+	k.pendingExpiryStore(ctx, expiryBTCHeight).Set(pendingEntryKey(valBTCPK, delBTCPK, stakingTxHash), sdk.Uint64ToBigEndian(power))
+}
+
+// CancelPendingExpiry removes a previously queued expiry, e.g. because the
+// delegation was unbonded early and is being removed from the cache right
+// away instead
+func (k Keeper) CancelPendingExpiry(ctx context.Context, expiryBTCHeight uint64, valBTCPK, delBTCPK, stakingTxHash []byte) {
+	k.pendingExpiryStore(ctx, expiryBTCHeight).Delete(pendingEntryKey(valBTCPK, delBTCPK, stakingTxHash))
+}
+
+// ProcessBTCTipUpdate applies every pending activation/expiry queued at a
+// BTC height in (prevBTCTip, newBTCTip] to the validator power cache, via
+// hooks, then clears the queue entries it has processed. This lets
+// RecordVotingPowerTable avoid rescanning every delegation on every block.
+func (k Keeper) ProcessBTCTipUpdate(ctx context.Context, hooks types.BTCStakingHooks, prevBTCTip, newBTCTip uint64) error {
+	if hooks == nil || newBTCTip <= prevBTCTip {
+		return nil
+	}
+
+	for height := prevBTCTip + 1; height <= newBTCTip; height++ {
+		if err := k.processPendingActivationAt(ctx, hooks, height); err != nil {
+			return err
+		}
+		if err := k.processPendingExpiryAt(ctx, hooks, height); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (k Keeper) processPendingActivationAt(ctx context.Context, hooks types.BTCStakingHooks, height uint64) error {
+	store := k.pendingActivationStore(ctx, height)
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	type entry struct {
+		key          []byte
+		power        uint64
+		expiryHeight uint64
+	}
+	entries := []entry{}
+	for ; iter.Valid(); iter.Next() {
+		value := iter.Value()
+		entries = append(entries, entry{
+			key:          append([]byte{}, iter.Key()...),
+			power:        sdk.BigEndianToUint64(value[0:8]),
+			expiryHeight: sdk.BigEndianToUint64(value[8:16]),
+		})
+	}
+
+	for _, e := range entries {
+		valBTCPK, delBTCPK, stakingTxHash := splitPendingEntryKey(e.key)
+		if err := hooks.AfterBTCDelegationActivated(ctx, valBTCPK, delBTCPK, stakingTxHash, e.expiryHeight, e.power); err != nil {
+			return err
+		}
+		store.Delete(e.key)
+	}
+	return nil
+}
+
+func (k Keeper) processPendingExpiryAt(ctx context.Context, hooks types.BTCStakingHooks, height uint64) error {
+	store := k.pendingExpiryStore(ctx, height)
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	type entry struct {
+		key   []byte
+		power uint64
+	}
+	entries := []entry{}
+	for ; iter.Valid(); iter.Next() {
+		entries = append(entries, entry{key: append([]byte{}, iter.Key()...), power: sdk.BigEndianToUint64(iter.Value())})
+	}
+
+	for _, e := range entries {
+		valBTCPK, delBTCPK, stakingTxHash := splitPendingEntryKey(e.key)
+		if err := hooks.AfterBTCDelegationExpired(ctx, valBTCPK, delBTCPK, stakingTxHash, e.power); err != nil {
+			return err
+		}
+		store.Delete(e.key)
+	}
+	return nil
+}
+
+// getProcessedBTCTipHeight returns the BTC tip height up to which pending
+// activations/expiries have already been applied to the power cache
+func (k Keeper) getProcessedBTCTipHeight(ctx context.Context) uint64 {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	bz := storeAdapter.Get(types.ProcessedBTCTipHeightKey)
+	if len(bz) == 0 {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+func (k Keeper) setProcessedBTCTipHeight(ctx context.Context, height uint64) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	storeAdapter.Set(types.ProcessedBTCTipHeightKey, sdk.Uint64ToBigEndian(height))
+}
+
+// splitPendingEntryKey recovers the (valBTCPK, delBTCPK, stakingTxHash)
+// triple from a pending-entry key. BIP340 x-only PKs are fixed 32 bytes and
+// the staking tx hash is a fixed 32-byte sha256d digest, so the split is
+// positional rather than length-prefixed.
+func splitPendingEntryKey(key []byte) (valBTCPK, delBTCPK, stakingTxHash []byte) {
+	const pkLen = 32
+	const txHashLen = 32
+	return key[0:pkLen], key[pkLen : 2*pkLen], key[2*pkLen : 2*pkLen+txHashLen]
+}
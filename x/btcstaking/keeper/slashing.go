@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/babylonchain/babylon/x/btcstaking/types"
+)
+
+// SlashBTCValidator marks a BTC validator as slashed: it records the
+// Babylon height the slashing took effect at (so btcVal.IsSlashed() starts
+// returning true), appends the validator's PK to SlashedValidatorSet for
+// downstream BTC-side slashing consumers, drops its cached voting power,
+// and emits a typed event.
+func (k Keeper) SlashBTCValidator(ctx context.Context, valBTCPK []byte, reason string) error {
+	btcVal, err := k.GetBTCValidator(ctx, valBTCPK)
+	if err != nil {
+		return err
+	}
+	if btcVal.IsSlashed() {
+		return types.ErrValidatorAlreadySlashed
+	}
+
+	slashedHeight := uint64(sdk.UnwrapSDKContext(ctx).BlockHeight())
+	btcVal.SlashedHeight = slashedHeight
+	k.SetBTCValidator(ctx, btcVal)
+
+	k.appendSlashedValidatorSet(ctx, valBTCPK, slashedHeight)
+
+	if err := k.AfterBTCValidatorSlashed(ctx, valBTCPK); err != nil {
+		return err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeBTCValidatorSlashed,
+		sdk.NewAttribute(types.AttributeKeyValidatorBTCPK, hex.EncodeToString(valBTCPK)),
+		sdk.NewAttribute(types.AttributeKeySlashedHeight, fmt.Sprintf("%d", slashedHeight)),
+		sdk.NewAttribute(types.AttributeKeySlashReason, reason),
+	))
+
+	return nil
+}
+
+func (k Keeper) slashedValidatorSetStore(ctx context.Context) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(storeAdapter, types.SlashedValidatorSetKey)
+}
+
+func (k Keeper) appendSlashedValidatorSet(ctx context.Context, valBTCPK []byte, slashedHeight uint64) {
+	k.slashedValidatorSetStore(ctx).Set(valBTCPK, sdk.Uint64ToBigEndian(slashedHeight))
+}
+
+// IterateSlashedValidatorSet iterates over every slashed BTC validator,
+// invoking fn with its PK and the Babylon height it was slashed at
+func (k Keeper) IterateSlashedValidatorSet(ctx context.Context, fn func(valBTCPK []byte, slashedHeight uint64) bool) {
+	store := k.slashedValidatorSetStore(ctx)
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		if !fn(iter.Key(), sdk.BigEndianToUint64(iter.Value())) {
+			return
+		}
+	}
+}
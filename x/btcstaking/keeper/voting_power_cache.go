@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/babylonchain/babylon/x/btcstaking/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// validatorPowerCacheStore returns the KVStore of the running, incrementally
+// maintained per-validator voting power tally.
+// key: BTC validator PK, value: voting power in Satoshi
+func (k Keeper) validatorPowerCacheStore(ctx context.Context) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(storeAdapter, types.ValidatorPowerCacheKey)
+}
+
+// GetCachedValidatorPower returns the cached voting power of a BTC validator
+func (k Keeper) GetCachedValidatorPower(ctx context.Context, valBTCPK []byte) uint64 {
+	bz := k.validatorPowerCacheStore(ctx).Get(valBTCPK)
+	if len(bz) == 0 {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+func (k Keeper) setCachedValidatorPower(ctx context.Context, valBTCPK []byte, power uint64) {
+	if power == 0 {
+		k.validatorPowerCacheStore(ctx).Delete(valBTCPK)
+		return
+	}
+	k.validatorPowerCacheStore(ctx).Set(valBTCPK, sdk.Uint64ToBigEndian(power))
+}
+
+// addValidatorPowerCache adds delta to a validator's cached voting power
+func (k Keeper) addValidatorPowerCache(ctx context.Context, valBTCPK []byte, delta uint64) {
+	k.setCachedValidatorPower(ctx, valBTCPK, k.GetCachedValidatorPower(ctx, valBTCPK)+delta)
+}
+
+// subValidatorPowerCache subtracts delta from a validator's cached voting
+// power, flooring at 0 so that out-of-order hook delivery cannot underflow it
+func (k Keeper) subValidatorPowerCache(ctx context.Context, valBTCPK []byte, delta uint64) {
+	current := k.GetCachedValidatorPower(ctx, valBTCPK)
+	if delta >= current {
+		k.setCachedValidatorPower(ctx, valBTCPK, 0)
+		return
+	}
+	k.setCachedValidatorPower(ctx, valBTCPK, current-delta)
+}
+
+// resetValidatorPowerCache drops a validator's entire cached voting power,
+// e.g. because it has been slashed
+func (k Keeper) resetValidatorPowerCache(ctx context.Context, valBTCPK []byte) {
+	k.validatorPowerCacheStore(ctx).Delete(valBTCPK)
+}
+
+// IterateValidatorPowerCache iterates over every BTC validator with non-zero
+// cached voting power, invoking fn with its PK and power. Iteration stops
+// early if fn returns false.
+func (k Keeper) IterateValidatorPowerCache(ctx context.Context, fn func(valBTCPK []byte, power uint64) bool) {
+	store := k.validatorPowerCacheStore(ctx)
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		if !fn(iter.Key(), sdk.BigEndianToUint64(iter.Value())) {
+			return
+		}
+	}
+}
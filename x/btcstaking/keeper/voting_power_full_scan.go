@@ -0,0 +1,93 @@
+package keeper
+
+import (
+	"context"
+
+	bbn "github.com/babylonchain/babylon/types"
+)
+
+// computeValidatorPowerFullScan recomputes every BTC validator's voting
+// power from scratch by walking every delegation, the way RecordVotingPowerTable
+// used to before voting power was tracked incrementally via BTCStakingHooks.
+// It exists solely to rebuild ValidatorPowerCache during migration and
+// genesis import, and to cross-check the cache in
+// ValidatorPowerCacheInvariant; the hot EndBlock path must not call this.
+func (k Keeper) computeValidatorPowerFullScan(ctx context.Context) (map[string]uint64, error) {
+	covenantQuorum := k.GetParams(ctx).CovenantQuorum
+	btcTipHeight, err := k.GetCurrentBTCHeight(ctx)
+	if err != nil {
+		return nil, err
+	}
+	wValue := k.btccKeeper.GetParams(ctx).CheckpointFinalizationTimeout
+
+	power := map[string]uint64{}
+	btcValIter := k.btcValidatorStore(ctx).Iterator(nil, nil)
+	defer btcValIter.Close()
+	for ; btcValIter.Valid(); btcValIter.Next() {
+		valBTCPKBytes := btcValIter.Key()
+		valBTCPK, err := bbn.NewBIP340PubKey(valBTCPKBytes)
+		if err != nil {
+			return nil, err
+		}
+		btcVal, err := k.GetBTCValidator(ctx, valBTCPKBytes)
+		if err != nil {
+			return nil, err
+		}
+		if btcVal.IsSlashed() {
+			continue
+		}
+
+		valPower := uint64(0)
+		btcDelIter := k.btcDelegatorStore(ctx, valBTCPK).Iterator(nil, nil)
+		for ; btcDelIter.Valid(); btcDelIter.Next() {
+			delBTCPK, err := bbn.NewBIP340PubKey(btcDelIter.Key())
+			if err != nil {
+				btcDelIter.Close()
+				return nil, err
+			}
+			btcDels, err := k.getBTCDelegatorDelegations(ctx, valBTCPK, delBTCPK)
+			if err != nil {
+				btcDelIter.Close()
+				return nil, err
+			}
+			valPower += btcDels.VotingPower(btcTipHeight, wValue, covenantQuorum)
+		}
+		btcDelIter.Close()
+
+		if valPower > 0 {
+			power[valBTCPK.MarshalHex()] = valPower
+		}
+	}
+
+	return power, nil
+}
+
+// RebuildValidatorPowerCache recomputes ValidatorPowerCache from scratch via
+// a full delegation scan. It is called from Migrate2to3, when incremental
+// power tracking is first enabled on a chain that already has delegations,
+// and from InitGenesis, after a genesis-based restart.
+func (k Keeper) RebuildValidatorPowerCache(ctx context.Context) error {
+	power, err := k.computeValidatorPowerFullScan(ctx)
+	if err != nil {
+		return err
+	}
+
+	for hexPK, p := range power {
+		valBTCPK, err := bbn.NewBIP340PubKeyFromHex(hexPK)
+		if err != nil {
+			return err
+		}
+		k.setCachedValidatorPower(ctx, valBTCPK.MustMarshal(), p)
+	}
+
+	k.setProcessedBTCTipHeight(ctx, k.currentBTCTipOrZero(ctx))
+	return nil
+}
+
+func (k Keeper) currentBTCTipOrZero(ctx context.Context) uint64 {
+	height, err := k.GetCurrentBTCHeight(ctx)
+	if err != nil {
+		return 0
+	}
+	return height
+}
@@ -15,22 +15,45 @@ import (
 // RecordVotingPowerTable computes the voting power table at the current block height
 // and saves the power table to KVStore
 // triggered upon each EndBlock
+//
+// Rather than rescanning every validator's delegations every block, the
+// validator power cache is kept up to date incrementally by BTCStakingHooks
+// fired from delegation lifecycle transitions (see hooks.go). This only
+// walks the slice of pending activations/expiries between the previous and
+// current BTC tip (see pending_queue.go), then copies the resulting cache
+// into the height-indexed snapshot.
 func (k Keeper) RecordVotingPowerTable(ctx context.Context) {
-	covenantQuorum := k.GetParams(ctx).CovenantQuorum
 	// tip of Babylon and Bitcoin
 	babylonTipHeight := uint64(sdk.UnwrapSDKContext(ctx).BlockHeight())
 	btcTipHeight, err := k.GetCurrentBTCHeight(ctx)
 	if err != nil {
 		return
 	}
-	// get value of w
-	wValue := k.btccKeeper.GetParams(ctx).CheckpointFinalizationTimeout
 
-	// filter out all BTC validators with positive voting power
+	prevBTCTipHeight := k.getProcessedBTCTipHeight(ctx)
+	if err := k.ProcessBTCTipUpdate(ctx, k, prevBTCTipHeight, btcTipHeight); err != nil {
+		// a hook failing here means the cache is a programming error away
+		// from diverging from the ground truth, so we surface it loudly
+		panic(err)
+	}
+	k.setProcessedBTCTipHeight(ctx, btcTipHeight)
+
+	// prune the snapshot that has just fallen out of the retention window,
+	// unless retention is disabled (0) or it would prune the activation
+	// height. This must run unconditionally, before the early return below:
+	// a block with no active BTC validator would otherwise skip pruning the
+	// height that fell out of the window this block, and since each call
+	// only prunes that single height, it would never be pruned later either.
+	if retention := k.GetParams(ctx).VotingPowerRetentionBlocks; retention > 0 && babylonTipHeight > retention {
+		pruneHeight := babylonTipHeight - retention
+		if activatedHeight, activatedErr := k.GetBTCStakingActivatedHeight(ctx); activatedErr != nil || pruneHeight != activatedHeight {
+			k.PruneVotingPowerTable(ctx, pruneHeight)
+		}
+	}
+
+	// filter out all BTC validators with positive cached voting power
 	activeBTCVals := []*types.BTCValidatorWithMeta{}
-	btcValIter := k.btcValidatorStore(ctx).Iterator(nil, nil)
-	for ; btcValIter.Valid(); btcValIter.Next() {
-		valBTCPKBytes := btcValIter.Key()
+	k.IterateValidatorPowerCache(ctx, func(valBTCPKBytes []byte, power uint64) bool {
 		valBTCPK, err := bbn.NewBIP340PubKey(valBTCPKBytes)
 		if err != nil {
 			// failed to unmarshal BTC validator PK in KVStore is a programming error
@@ -43,36 +66,16 @@ func (k Keeper) RecordVotingPowerTable(ctx context.Context) {
 		}
 		if btcVal.IsSlashed() {
 			// slashed BTC validator is removed from BTC validator set
-			continue
+			return true
 		}
 
-		valPower := uint64(0)
-
-		// iterate all BTC delegations under this validator
-		// to calculate this validator's total voting power
-		btcDelIter := k.btcDelegatorStore(ctx, valBTCPK).Iterator(nil, nil)
-		for ; btcDelIter.Valid(); btcDelIter.Next() {
-			delBTCPK, err := bbn.NewBIP340PubKey(btcDelIter.Key())
-			if err != nil {
-				panic(err) // only programming error is possible
-			}
-			btcDels, err := k.getBTCDelegatorDelegations(ctx, valBTCPK, delBTCPK)
-			if err != nil {
-				panic(err) // only programming error is possible
-			}
-			valPower += btcDels.VotingPower(btcTipHeight, wValue, covenantQuorum)
-		}
-		btcDelIter.Close()
-
-		if valPower > 0 {
-			activeBTCVals = append(activeBTCVals, &types.BTCValidatorWithMeta{
-				BtcPk:       valBTCPK,
-				VotingPower: valPower,
-				// other fields do not matter
-			})
-		}
-	}
-	btcValIter.Close()
+		activeBTCVals = append(activeBTCVals, &types.BTCValidatorWithMeta{
+			BtcPk:       valBTCPK,
+			VotingPower: power,
+			// other fields do not matter
+		})
+		return true
+	})
 
 	// return directly if there is no active BTC validator
 	if len(activeBTCVals) == 0 {
@@ -86,6 +89,49 @@ func (k Keeper) RecordVotingPowerTable(ctx context.Context) {
 	for _, btcVal := range activeBTCVals {
 		k.SetVotingPower(ctx, btcVal.BtcPk.MustMarshal(), babylonTipHeight, btcVal.VotingPower)
 	}
+
+	// cache the activation height now that the table is non-empty, so later
+	// pruning of old snapshots can no longer make it drift
+	_, _ = k.GetBTCStakingActivatedHeight(ctx)
+}
+
+// PruneVotingPowerTable deletes the voting power snapshot recorded at the
+// given Babylon height. It is a no-op if no snapshot exists at that height.
+func (k Keeper) PruneVotingPowerTable(ctx context.Context, height uint64) {
+	store := k.votingPowerStore(ctx, height)
+	iter := store.Iterator(nil, nil)
+	keys := [][]byte{}
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// IterateVotingPowerTables iterates over all height-indexed voting power
+// tables still present in the store, in ascending height order, invoking fn
+// with the height and the validator set recorded at that height. Iteration
+// stops early if fn returns false.
+func (k Keeper) IterateVotingPowerTables(ctx context.Context, fn func(height uint64, powerTable map[string]uint64) bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	votingPowerStore := prefix.NewStore(storeAdapter, types.VotingPowerKey)
+	iter := votingPowerStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	seenHeights := map[uint64]bool{}
+	for ; iter.Valid(); iter.Next() {
+		height := sdk.BigEndianToUint64(iter.Key()[:8])
+		if seenHeights[height] {
+			continue
+		}
+		seenHeights[height] = true
+		if !fn(height, k.GetVotingPowerTable(ctx, height)) {
+			return
+		}
+	}
 }
 
 // SetVotingPower sets the voting power of a given BTC validator at a given Babylon height
@@ -143,26 +189,35 @@ func (k Keeper) GetVotingPowerTable(ctx context.Context, height uint64) map[stri
 // GetBTCStakingActivatedHeight returns the height when the BTC staking protocol is activated
 // i.e., the first height where a BTC validator has voting power
 // Before the BTC staking protocol is activated, we don't index or tally any block
+//
+// The activation height is cached under ActivatedHeightKey the first time it
+// is computed, so that it keeps returning the correct value once historical
+// voting power snapshots start being pruned (see RecordVotingPowerTable).
 func (k Keeper) GetBTCStakingActivatedHeight(ctx context.Context) (uint64, error) {
 	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+
+	if cachedBytes := storeAdapter.Get(types.ActivatedHeightKey); len(cachedBytes) > 0 {
+		return sdk.BigEndianToUint64(cachedBytes), nil
+	}
+
 	votingPowerStore := prefix.NewStore(storeAdapter, types.VotingPowerKey)
 	iter := votingPowerStore.Iterator(nil, nil)
 	defer iter.Close()
 	// if the iterator is valid, then there exists a height that has a BTC validator with voting power
-	if iter.Valid() {
-		return sdk.BigEndianToUint64(iter.Key()), nil
-	} else {
+	if !iter.Valid() {
 		return 0, types.ErrBTCStakingNotActivated
 	}
+
+	activatedHeight := sdk.BigEndianToUint64(iter.Key())
+	storeAdapter.Set(types.ActivatedHeightKey, sdk.Uint64ToBigEndian(activatedHeight))
+	return activatedHeight, nil
 }
 
+// IsBTCStakingActivated returns whether the BTC staking protocol has reached
+// non-zero voting power at least once
 func (k Keeper) IsBTCStakingActivated(ctx context.Context) bool {
-	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	votingPowerStore := prefix.NewStore(storeAdapter, types.VotingPowerKey)
-	iter := votingPowerStore.Iterator(nil, nil)
-	defer iter.Close()
-	// if the iterator is valid, then BTC staking is already activated
-	return iter.Valid()
+	_, err := k.GetBTCStakingActivatedHeight(ctx)
+	return err == nil
 }
 
 // votingPowerStore returns the KVStore of the BTC validators' voting power
@@ -0,0 +1,28 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+)
+
+var (
+	// ErrBTCStakingNotActivated is returned when the BTC staking protocol has
+	// not yet seen any BTC validator reach non-zero voting power
+	ErrBTCStakingNotActivated = sdkerrors.Register(ModuleName, 1100, "BTC staking protocol is not activated yet")
+
+	// ErrVotingPowerTablePruned is returned when querying the voting power
+	// table at a height whose snapshot has already been pruned per the
+	// VotingPowerRetentionBlocks param
+	ErrVotingPowerTablePruned = sdkerrors.Register(ModuleName, 1101, "voting power table at this height has been pruned")
+
+	// ErrInvalidEvidence is returned when a submitted slashing evidence is
+	// malformed or fails signature verification
+	ErrInvalidEvidence = sdkerrors.Register(ModuleName, 1102, "invalid slashing evidence")
+
+	// ErrEvidenceTooOld is returned when evidence refers to a height older
+	// than the MaxEvidenceAge param allows
+	ErrEvidenceTooOld = sdkerrors.Register(ModuleName, 1103, "evidence refers to a height beyond the slashing grace period")
+
+	// ErrValidatorAlreadySlashed is returned when evidence is submitted
+	// against a BTC validator that has already been slashed
+	ErrValidatorAlreadySlashed = sdkerrors.Register(ModuleName, 1104, "BTC validator has already been slashed")
+)
@@ -0,0 +1,10 @@
+package types
+
+// btcstaking module event types and attribute keys
+const (
+	EventTypeBTCValidatorSlashed = "btc_validator_slashed"
+
+	AttributeKeyValidatorBTCPK = "validator_btc_pk"
+	AttributeKeySlashedHeight  = "slashed_height"
+	AttributeKeySlashReason    = "reason"
+)
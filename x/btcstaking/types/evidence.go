@@ -0,0 +1,97 @@
+package types
+
+import (
+	"bytes"
+
+	bbn "github.com/babylonchain/babylon/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ sdk.Msg = (*MsgSubmitBTCValidatorEvidence)(nil)
+
+// MsgSubmitBTCValidatorEvidence submits cryptographic proof that a BTC
+// validator has equivocated, either by double-signing Babylon blocks with
+// its BTC key or double-signing checkpoints with its BLS key. Exactly one
+// of DoubleSign or BlsDoubleSign must be set.
+type MsgSubmitBTCValidatorEvidence struct {
+	Submitter     string
+	DoubleSign    *DoubleSignEvidence
+	BlsDoubleSign *BlsDoubleSignEvidence
+}
+
+// DoubleSignEvidence proves that a BTC validator used its BTC key to sign
+// two conflicting Babylon block hashes at the same height
+type DoubleSignEvidence struct {
+	ValBtcPk   *bbn.BIP340PubKey
+	Height     uint64
+	BlockHash1 []byte
+	Sig1       []byte
+	BlockHash2 []byte
+	Sig2       []byte
+}
+
+// BlsDoubleSignEvidence proves that a BTC validator used its BLS key to
+// sign two conflicting checkpoints in the same epoch
+type BlsDoubleSignEvidence struct {
+	ValBtcPk   *bbn.BIP340PubKey
+	Epoch      uint64
+	BlockHash1 []byte
+	Sig1       []byte
+	BlockHash2 []byte
+	Sig2       []byte
+}
+
+func (m *MsgSubmitBTCValidatorEvidence) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Submitter); err != nil {
+		return err
+	}
+
+	switch {
+	case m.DoubleSign != nil && m.BlsDoubleSign != nil:
+		return ErrInvalidEvidence.Wrap("exactly one of double_sign or bls_double_sign must be set, got both")
+	case m.DoubleSign != nil:
+		return m.DoubleSign.ValidateBasic()
+	case m.BlsDoubleSign != nil:
+		return m.BlsDoubleSign.ValidateBasic()
+	default:
+		return ErrInvalidEvidence.Wrap("exactly one of double_sign or bls_double_sign must be set, got neither")
+	}
+}
+
+func (m *MsgSubmitBTCValidatorEvidence) GetSigners() []sdk.AccAddress {
+	submitter, err := sdk.AccAddressFromBech32(m.Submitter)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{submitter}
+}
+
+// ValidateBasic performs stateless sanity checks: it cannot verify the
+// signatures themselves, since that requires the validator's registered
+// BTC public key to be looked up in state
+func (e *DoubleSignEvidence) ValidateBasic() error {
+	if e.ValBtcPk == nil {
+		return ErrInvalidEvidence.Wrap("validator BTC PK is empty")
+	}
+	if bytes.Equal(e.BlockHash1, e.BlockHash2) {
+		return ErrInvalidEvidence.Wrap("the two signed block hashes must be different to prove equivocation")
+	}
+	if len(e.Sig1) == 0 || len(e.Sig2) == 0 {
+		return ErrInvalidEvidence.Wrap("both signatures are required")
+	}
+	return nil
+}
+
+// ValidateBasic performs stateless sanity checks; see DoubleSignEvidence.ValidateBasic
+func (e *BlsDoubleSignEvidence) ValidateBasic() error {
+	if e.ValBtcPk == nil {
+		return ErrInvalidEvidence.Wrap("validator BTC PK is empty")
+	}
+	if bytes.Equal(e.BlockHash1, e.BlockHash2) {
+		return ErrInvalidEvidence.Wrap("the two signed checkpoints must be different to prove equivocation")
+	}
+	if len(e.Sig1) == 0 || len(e.Sig2) == 0 {
+		return ErrInvalidEvidence.Wrap("both signatures are required")
+	}
+	return nil
+}
@@ -0,0 +1,42 @@
+package types
+
+import "fmt"
+
+// GenesisState defines the btcstaking module's genesis state
+type GenesisState struct {
+	Params Params
+
+	// VotingPowerTables holds every height-indexed BTC validator voting
+	// power snapshot still present in the store at export time; a
+	// zero-height export instead holds only the most recent snapshot, at
+	// height 1
+	VotingPowerTables []HeightVotingPower
+}
+
+// HeightVotingPower is a single height-indexed voting power snapshot,
+// i.e. the BTC validator set (by hex-encoded BTC PK) active at that height
+type HeightVotingPower struct {
+	Height      uint64
+	VotingPower map[string]uint64
+}
+
+// DefaultGenesis returns the default btcstaking genesis state
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
+}
+
+// Validate performs basic genesis state validation, returning an error upon
+// any failure
+func (gs GenesisState) Validate() error {
+	seenHeights := map[uint64]bool{}
+	for _, vpt := range gs.VotingPowerTables {
+		if seenHeights[vpt.Height] {
+			return fmt.Errorf("duplicate voting power table at height %d", vpt.Height)
+		}
+		seenHeights[vpt.Height] = true
+	}
+
+	return gs.Params.Validate()
+}
@@ -0,0 +1,95 @@
+package types
+
+import "context"
+
+// BTCStakingHooks defines the lifecycle events of a BTC delegation that
+// affect a validator's voting power. Hooks let the voting power cache be
+// updated incrementally as delegations move through covenant signing,
+// activation, expiry and unbonding, instead of recomputing the full power
+// table from scratch every block.
+type BTCStakingHooks interface {
+	// AfterCovenantQuorumReached is called once a BTC delegation has
+	// gathered enough covenant signatures. If the BTC tip has not yet
+	// reached activationBTCHeight, the delegation is queued to activate once
+	// it does; since covenant signing can finish after the tip has already
+	// passed activationBTCHeight (which depends only on confirmation depth,
+	// not on when quorum is reached), it activates immediately in that case
+	// instead, and its removal is queued for expiryBTCHeight either way.
+	AfterCovenantQuorumReached(ctx context.Context, valBTCPK, delBTCPK, stakingTxHash []byte, activationBTCHeight, expiryBTCHeight uint64, power uint64) error
+
+	// AfterBTCDelegationActivated is called once a queued delegation's
+	// activation height has been reached. Its power is added to the
+	// validator's cached tally, and its removal is queued for
+	// expiryBTCHeight.
+	AfterBTCDelegationActivated(ctx context.Context, valBTCPK, delBTCPK, stakingTxHash []byte, expiryBTCHeight uint64, power uint64) error
+
+	// AfterBTCDelegationExpired is called once an active delegation's
+	// timelock has expired. Its power is removed from the validator's
+	// cached tally.
+	AfterBTCDelegationExpired(ctx context.Context, valBTCPK, delBTCPK, stakingTxHash []byte, power uint64) error
+
+	// AfterBTCDelegationUnbonded is called when an active delegation is
+	// unbonded before its timelock expires. Its power is removed from the
+	// validator's cached tally and its queued expiry at expiryBTCHeight is
+	// cancelled.
+	AfterBTCDelegationUnbonded(ctx context.Context, valBTCPK, delBTCPK, stakingTxHash []byte, expiryBTCHeight uint64, power uint64) error
+
+	// AfterBTCValidatorSlashed is called once a validator is slashed. Its
+	// entire cached voting power is dropped.
+	AfterBTCValidatorSlashed(ctx context.Context, valBTCPK []byte) error
+}
+
+var _ BTCStakingHooks = MultiBTCStakingHooks{}
+
+// MultiBTCStakingHooks combines multiple BTCStakingHooks, calling each of
+// them in order, analogous to cosmos-sdk staking's MultiStakingHooks.
+type MultiBTCStakingHooks []BTCStakingHooks
+
+func NewMultiBTCStakingHooks(hooks ...BTCStakingHooks) MultiBTCStakingHooks {
+	return hooks
+}
+
+func (h MultiBTCStakingHooks) AfterCovenantQuorumReached(ctx context.Context, valBTCPK, delBTCPK, stakingTxHash []byte, activationBTCHeight, expiryBTCHeight uint64, power uint64) error {
+	for _, hook := range h {
+		if err := hook.AfterCovenantQuorumReached(ctx, valBTCPK, delBTCPK, stakingTxHash, activationBTCHeight, expiryBTCHeight, power); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiBTCStakingHooks) AfterBTCDelegationActivated(ctx context.Context, valBTCPK, delBTCPK, stakingTxHash []byte, expiryBTCHeight uint64, power uint64) error {
+	for _, hook := range h {
+		if err := hook.AfterBTCDelegationActivated(ctx, valBTCPK, delBTCPK, stakingTxHash, expiryBTCHeight, power); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiBTCStakingHooks) AfterBTCDelegationExpired(ctx context.Context, valBTCPK, delBTCPK, stakingTxHash []byte, power uint64) error {
+	for _, hook := range h {
+		if err := hook.AfterBTCDelegationExpired(ctx, valBTCPK, delBTCPK, stakingTxHash, power); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiBTCStakingHooks) AfterBTCDelegationUnbonded(ctx context.Context, valBTCPK, delBTCPK, stakingTxHash []byte, expiryBTCHeight uint64, power uint64) error {
+	for _, hook := range h {
+		if err := hook.AfterBTCDelegationUnbonded(ctx, valBTCPK, delBTCPK, stakingTxHash, expiryBTCHeight, power); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiBTCStakingHooks) AfterBTCValidatorSlashed(ctx context.Context, valBTCPK []byte) error {
+	for _, hook := range h {
+		if err := hook.AfterBTCValidatorSlashed(ctx, valBTCPK); err != nil {
+			return err
+		}
+	}
+	return nil
+}
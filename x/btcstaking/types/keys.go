@@ -0,0 +1,56 @@
+package types
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "btcstaking"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+)
+
+var (
+	// VotingPowerKey is the key prefix under which the height-indexed BTC
+	// validator voting power table is stored:
+	// (VotingPowerKey || babylonHeight) -> BTC validator PK -> voting power
+	VotingPowerKey = []byte{0x02}
+
+	// ActivatedHeightKey caches the Babylon height at which BTC staking
+	// first reached non-zero voting power. It is populated once and never
+	// moves, so it remains correct even after older voting power snapshots
+	// are pruned.
+	ActivatedHeightKey = []byte{0x03}
+
+	// ValidatorPowerCacheKey is the key prefix of the running, incrementally
+	// maintained per-validator voting power tally:
+	// (ValidatorPowerCacheKey || BTC validator PK) -> voting power
+	ValidatorPowerCacheKey = []byte{0x04}
+
+	// PendingActivationKey is the key prefix of BTC delegations that have
+	// reached covenant quorum but are still waiting for the BTC tip to reach
+	// their activation height:
+	// (PendingActivationKey || BTC height || BTC validator PK || BTC delegator PK || staking tx hash) -> (power || expiry BTC height)
+	PendingActivationKey = []byte{0x05}
+
+	// PendingExpiryKey is the key prefix of active BTC delegations indexed
+	// by the BTC height at which their timelock expires:
+	// (PendingExpiryKey || BTC height || BTC validator PK || BTC delegator PK || staking tx hash) -> power
+	PendingExpiryKey = []byte{0x06}
+
+	// ProcessedBTCTipHeightKey caches the BTC tip height up to which
+	// PendingActivationKey/PendingExpiryKey have already been applied to
+	// ValidatorPowerCacheKey, so that RecordVotingPowerTable only has to walk
+	// the delta since the previous BTC tip
+	ProcessedBTCTipHeightKey = []byte{0x07}
+
+	// SlashedValidatorSetKey is the key prefix of the set of BTC validators
+	// that have been slashed, appended to as evidence is processed so that
+	// BTC-side slashing consumers can pick up newly slashed validators:
+	// (SlashedValidatorSetKey || BTC validator PK) -> Babylon height slashed at
+	SlashedValidatorSetKey = []byte{0x08}
+
+	// EpochReferenceHeightKey is the key prefix caching, for each (epoch,
+	// BTC validator PK) pair, the Babylon height at which that validator's
+	// verified BLS double-sign evidence for the epoch was first seen:
+	// (EpochReferenceHeightKey || epoch || BTC validator PK) -> Babylon height
+	EpochReferenceHeightKey = []byte{0x09}
+)
@@ -0,0 +1,47 @@
+package types
+
+import "fmt"
+
+// Params defines the parameters for the btcstaking module
+type Params struct {
+	// CovenantQuorum is the number of covenant signatures required for a BTC
+	// delegation to be eligible for activation
+	CovenantQuorum uint32
+	// MaxActiveBtcValidators caps how many BTC validators, ranked by voting
+	// power, are active at any given height
+	MaxActiveBtcValidators uint32
+	// MaxEvidenceAge bounds how many Babylon blocks after the fact slashing
+	// evidence may still be submitted for, mirroring the grace period of
+	// cosmos-sdk x/slashing's MaxEvidenceAge. 0 disables the bound.
+	MaxEvidenceAge uint64
+	// VotingPowerRetentionBlocks bounds how many Babylon blocks of historical
+	// voting power snapshots are kept before being pruned by
+	// RecordVotingPowerTable. 0 disables pruning. Must be at least
+	// MaxEvidenceAge, so that slashing evidence within its grace period can
+	// never reference a snapshot that has already been pruned.
+	VotingPowerRetentionBlocks uint64
+}
+
+// DefaultParams returns the default btcstaking parameters
+func DefaultParams() Params {
+	return Params{
+		CovenantQuorum:             1,
+		MaxActiveBtcValidators:     100,
+		MaxEvidenceAge:             100000,
+		VotingPowerRetentionBlocks: 100000,
+	}
+}
+
+// Validate performs basic validation of the btcstaking parameters
+func (p Params) Validate() error {
+	if p.CovenantQuorum == 0 {
+		return fmt.Errorf("covenant quorum must be positive")
+	}
+	if p.MaxActiveBtcValidators == 0 {
+		return fmt.Errorf("max active BTC validators must be positive")
+	}
+	if p.VotingPowerRetentionBlocks > 0 && p.VotingPowerRetentionBlocks < p.MaxEvidenceAge {
+		return fmt.Errorf("voting power retention blocks (%d) must be at least max evidence age (%d), otherwise slashing evidence within its grace period could reference an already-pruned voting power snapshot", p.VotingPowerRetentionBlocks, p.MaxEvidenceAge)
+	}
+	return nil
+}
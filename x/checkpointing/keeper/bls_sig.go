@@ -0,0 +1,210 @@
+package keeper
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/babylonchain/babylon/crypto/bls12381"
+	"github.com/babylonchain/babylon/x/checkpointing/types"
+)
+
+// AddBlsSig verifies a single validator's BLS signature over
+// (sig.Epoch, sig.BlockHash) and folds it into the running BlsSigSet for
+// that checkpoint via point addition on G1 (public keys) and G2
+// (signatures). Once the cumulative voting power of contributing
+// validators crosses the 2/3+ threshold, it finalizes the checkpoint and
+// emits a FinalizedCheckpoint event carrying the single aggregated
+// signature and contributor bitmap.
+func (k Keeper) AddBlsSig(ctx context.Context, sig *types.BlsSig) error {
+	signerAddr, err := sdk.AccAddressFromBech32(sig.SignerAddress)
+	if err != nil {
+		return err
+	}
+
+	valSet, err := k.GetValidatorBlsKeySet(ctx, sig.Epoch)
+	if err != nil {
+		return err
+	}
+	valIdx, val := findValidatorWithBlsKey(valSet, signerAddr)
+	if val == nil {
+		return types.ErrInvalidBlsSig.Wrapf("address %s is not part of the BLS validator set at epoch %d", signerAddr, sig.Epoch)
+	}
+
+	if !bls12381.Verify(val.BlsPubKey, checkpointSignBytes(sig.Epoch, sig.BlockHash), sig.BlsSig) {
+		return types.ErrInvalidBlsSig
+	}
+
+	sigSet := k.getOrInitBlsSigSet(ctx, sig.Epoch, sig.BlockHash, len(valSet.ValSet))
+	if sigSet.Bitmap.GetIndex(valIdx) {
+		return types.ErrDuplicateBlsSig
+	}
+
+	sigSet.AggrPk = bls12381.AggregatePublicKeys(sigSet.AggrPk, val.BlsPubKey)
+	sigSet.AggrSig = bls12381.AggregateSignatures(sigSet.AggrSig, sig.BlsSig)
+	if err := sigSet.Bitmap.SetIndex(valIdx, true); err != nil {
+		return err
+	}
+	sigSet.TotalPower += val.VotingPower
+
+	k.setBlsSigSet(ctx, sigSet)
+
+	if !sigSet.Finalized && sigSet.TotalPower*3 > totalVotingPower(valSet)*2 {
+		sigSet.Finalized = true
+		k.setBlsSigSet(ctx, sigSet)
+
+		bitmapBz, err := sigSet.Bitmap.Marshal()
+		if err != nil {
+			return err
+		}
+		sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeFinalizedCheckpoint,
+			sdk.NewAttribute(types.AttributeKeyEpoch, fmt.Sprintf("%d", sigSet.Epoch)),
+			sdk.NewAttribute(types.AttributeKeyBlockHash, hex.EncodeToString(sigSet.BlockHash)),
+			sdk.NewAttribute(types.AttributeKeyAggrPk, hex.EncodeToString(sigSet.AggrPk)),
+			sdk.NewAttribute(types.AttributeKeyAggrSig, hex.EncodeToString(sigSet.AggrSig)),
+			sdk.NewAttribute(types.AttributeKeyBitmap, hex.EncodeToString(bitmapBz)),
+		))
+	}
+
+	return nil
+}
+
+// findValidatorWithBlsKey returns the index and entry of addr within the
+// epoch's BLS validator set, or (-1, nil) if addr is not part of it
+func findValidatorWithBlsKey(valSet *types.ValidatorWithBlsKeySet, addr sdk.AccAddress) (int, *types.ValidatorWithBlsKey) {
+	for i, val := range valSet.ValSet {
+		if val.ValidatorAddress == addr.String() {
+			return i, val
+		}
+	}
+	return -1, nil
+}
+
+func totalVotingPower(valSet *types.ValidatorWithBlsKeySet) int64 {
+	var total int64
+	for _, val := range valSet.ValSet {
+		total += val.VotingPower
+	}
+	return total
+}
+
+// checkpointSignBytes returns the canonical bytes a validator's BLS
+// signature is computed over for a given checkpoint
+func checkpointSignBytes(epoch uint64, blockHash []byte) []byte {
+	bz := make([]byte, 0, 8+len(blockHash))
+	bz = append(bz, sdk.Uint64ToBigEndian(epoch)...)
+	bz = append(bz, blockHash...)
+	return bz
+}
+
+// blsSigSetStore returns the KVStore of in-progress BLS signature
+// aggregations, keyed by (epoch || blockHash)
+func (k Keeper) blsSigSetStore(ctx context.Context) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(storeAdapter, types.BlsSigSetKey)
+}
+
+func blsSigSetStoreKey(epoch uint64, blockHash []byte) []byte {
+	key := make([]byte, 0, 8+len(blockHash))
+	key = append(key, sdk.Uint64ToBigEndian(epoch)...)
+	key = append(key, blockHash...)
+	return key
+}
+
+// GetBlsSigSet returns the in-progress BLS signature aggregation for a
+// given (epoch, blockHash), or nil if no signature has been submitted yet
+func (k Keeper) GetBlsSigSet(ctx context.Context, epoch uint64, blockHash []byte) *types.BlsSigSet {
+	bz := k.blsSigSetStore(ctx).Get(blsSigSetStoreKey(epoch, blockHash))
+	if len(bz) == 0 {
+		return nil
+	}
+	return unmarshalBlsSigSet(bz, blockHash)
+}
+
+func (k Keeper) getOrInitBlsSigSet(ctx context.Context, epoch uint64, blockHash []byte, numVals int) *types.BlsSigSet {
+	if sigSet := k.GetBlsSigSet(ctx, epoch, blockHash); sigSet != nil {
+		return sigSet
+	}
+	return &types.BlsSigSet{
+		Epoch:      epoch,
+		BlockHash:  blockHash,
+		AggrPk:     bls12381.InfinityPublicKey(),
+		AggrSig:    bls12381.InfinitySignature(),
+		Bitmap:     sdk.NewCompactBitArray(numVals),
+		TotalPower: 0,
+		Finalized:  false,
+	}
+}
+
+func (k Keeper) setBlsSigSet(ctx context.Context, sigSet *types.BlsSigSet) {
+	k.blsSigSetStore(ctx).Set(blsSigSetStoreKey(sigSet.Epoch, sigSet.BlockHash), marshalBlsSigSet(sigSet))
+}
+
+// IterateBlsSigSets iterates over every BLS signature aggregation still
+// present in the store, invoking fn with each one. Iteration stops early if
+// fn returns false.
+func (k Keeper) IterateBlsSigSets(ctx context.Context, fn func(sigSet *types.BlsSigSet) bool) {
+	store := k.blsSigSetStore(ctx)
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		blockHash := append([]byte{}, iter.Key()[8:]...)
+		if !fn(unmarshalBlsSigSet(iter.Value(), blockHash)) {
+			return
+		}
+	}
+}
+
+// marshalBlsSigSet packs a BlsSigSet into a flat byte layout: epoch (8B) ||
+// AggrPk (fixed) || AggrSig (fixed) || TotalPower (8B) || Finalized (1B) ||
+// Bitmap (remaining bytes, self-delimiting via its own encoding). BlockHash
+// is not included: it is already part of the store key, so the caller
+// supplies it back on read.
+func marshalBlsSigSet(s *types.BlsSigSet) []byte {
+	bitmapBz, err := s.Bitmap.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	buf := make([]byte, 0, 8+bls12381.PublicKeySize+bls12381.SignatureSize+8+1+len(bitmapBz))
+	buf = append(buf, sdk.Uint64ToBigEndian(s.Epoch)...)
+	buf = append(buf, s.AggrPk...)
+	buf = append(buf, s.AggrSig...)
+	buf = append(buf, sdk.Uint64ToBigEndian(uint64(s.TotalPower))...)
+	if s.Finalized {
+		buf = append(buf, 1)
+	} else {
+		buf = append(buf, 0)
+	}
+	buf = append(buf, bitmapBz...)
+	return buf
+}
+
+func unmarshalBlsSigSet(bz []byte, blockHash []byte) *types.BlsSigSet {
+	pkEnd := 8 + bls12381.PublicKeySize
+	sigEnd := pkEnd + bls12381.SignatureSize
+	powerEnd := sigEnd + 8
+	finalizedEnd := powerEnd + 1
+
+	bitmap := &sdk.CompactBitArray{}
+	if err := bitmap.Unmarshal(bz[finalizedEnd:]); err != nil {
+		panic(err)
+	}
+
+	return &types.BlsSigSet{
+		Epoch:      sdk.BigEndianToUint64(bz[0:8]),
+		BlockHash:  blockHash,
+		AggrPk:     append(bls12381.PublicKey{}, bz[8:pkEnd]...),
+		AggrSig:    append(bls12381.Signature{}, bz[pkEnd:sigEnd]...),
+		TotalPower: int64(sdk.BigEndianToUint64(bz[sigEnd:powerEnd])),
+		Finalized:  bz[powerEnd] == 1,
+		Bitmap:     bitmap,
+	}
+}
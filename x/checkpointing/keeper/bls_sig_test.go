@@ -0,0 +1,139 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/babylon/crypto/bls12381"
+	keepertest "github.com/babylonchain/babylon/testutil/keeper"
+	"github.com/babylonchain/babylon/x/checkpointing/types"
+)
+
+// blsValidator bundles a BLS-signing validator for test setup: a bech32
+// address, its registered BLS key pair, and its voting power at the epoch
+// under test.
+type blsValidator struct {
+	addr  sdk.AccAddress
+	priv  bls12381.PrivateKey
+	power int64
+}
+
+func newBlsValidators(t *testing.T, n int, powerEach int64) []blsValidator {
+	vals := make([]blsValidator, n)
+	for i := range vals {
+		priv := bls12381.GenPrivKey()
+		vals[i] = blsValidator{
+			addr:  sdk.AccAddress([]byte{byte(i + 1)}),
+			priv:  priv,
+			power: powerEach,
+		}
+	}
+	return vals
+}
+
+func setupEpochValSet(t *testing.T, ctx context.Context, k interface {
+	SetValidatorBlsKeySet(ctx context.Context, epoch uint64, valSet *types.ValidatorWithBlsKeySet)
+}, epoch uint64, vals []blsValidator) {
+	entries := make([]*types.ValidatorWithBlsKey, len(vals))
+	for i, v := range vals {
+		entries[i] = &types.ValidatorWithBlsKey{
+			ValidatorAddress: v.addr.String(),
+			BlsPubKey:        v.priv.PubKey(),
+			VotingPower:      v.power,
+		}
+	}
+	k.SetValidatorBlsKeySet(ctx, epoch, &types.ValidatorWithBlsKeySet{ValSet: entries})
+}
+
+// checkpointSignBytes mirrors the keeper package's unexported helper of the
+// same name: the canonical bytes a validator's BLS signature over a
+// checkpoint is computed over.
+func checkpointSignBytes(epoch uint64, blockHash []byte) []byte {
+	bz := make([]byte, 0, 8+len(blockHash))
+	bz = append(bz, sdk.Uint64ToBigEndian(epoch)...)
+	bz = append(bz, blockHash...)
+	return bz
+}
+
+func addBlsSigMsg(t *testing.T, v blsValidator, epoch uint64, blockHash []byte) *types.BlsSig {
+	sig, err := v.priv.Sign(checkpointSignBytes(epoch, blockHash))
+	require.NoError(t, err)
+	return &types.BlsSig{
+		Epoch:         epoch,
+		SignerAddress: v.addr.String(),
+		BlockHash:     blockHash,
+		BlsSig:        sig,
+	}
+}
+
+func TestAddBlsSigAggregatesAndFinalizesAtThreshold(t *testing.T) {
+	k, ctx := keepertest.CheckpointingKeeper(t)
+	const epoch = uint64(1)
+	blockHash := []byte("block-hash-under-test")
+
+	vals := newBlsValidators(t, 4, 25) // 100 total voting power, 2/3+ quorum is 67+
+	setupEpochValSet(t, ctx, k, epoch, vals)
+
+	// first two signatures (50/100) do not cross the 2/3+ threshold yet
+	for _, v := range vals[:2] {
+		require.NoError(t, k.AddBlsSig(ctx, addBlsSigMsg(t, v, epoch, blockHash)))
+	}
+	sigSet := k.GetBlsSigSet(ctx, epoch, blockHash)
+	require.NotNil(t, sigSet)
+	require.False(t, sigSet.Finalized)
+	require.Equal(t, int64(50), sigSet.TotalPower)
+
+	// the third signature (75/100) crosses the threshold and finalizes it
+	require.NoError(t, k.AddBlsSig(ctx, addBlsSigMsg(t, vals[2], epoch, blockHash)))
+	sigSet = k.GetBlsSigSet(ctx, epoch, blockHash)
+	require.NotNil(t, sigSet)
+	require.True(t, sigSet.Finalized)
+	require.Equal(t, int64(75), sigSet.TotalPower)
+}
+
+func TestAddBlsSigRejectsDuplicateSigner(t *testing.T) {
+	k, ctx := keepertest.CheckpointingKeeper(t)
+	const epoch = uint64(1)
+	blockHash := []byte("block-hash-under-test")
+
+	vals := newBlsValidators(t, 2, 50)
+	setupEpochValSet(t, ctx, k, epoch, vals)
+
+	require.NoError(t, k.AddBlsSig(ctx, addBlsSigMsg(t, vals[0], epoch, blockHash)))
+	err := k.AddBlsSig(ctx, addBlsSigMsg(t, vals[0], epoch, blockHash))
+	require.ErrorIs(t, err, types.ErrDuplicateBlsSig)
+}
+
+func TestAddBlsSigRejectsBadSignature(t *testing.T) {
+	k, ctx := keepertest.CheckpointingKeeper(t)
+	const epoch = uint64(1)
+	blockHash := []byte("block-hash-under-test")
+
+	vals := newBlsValidators(t, 2, 50)
+	setupEpochValSet(t, ctx, k, epoch, vals)
+
+	msg := addBlsSigMsg(t, vals[0], epoch, blockHash)
+	msg.BlsSig[0] ^= 0xff // corrupt the signature without changing its length
+
+	err := k.AddBlsSig(ctx, msg)
+	require.ErrorIs(t, err, types.ErrInvalidBlsSig)
+}
+
+func TestAddBlsSigRejectsNonValidatorSigner(t *testing.T) {
+	k, ctx := keepertest.CheckpointingKeeper(t)
+	const epoch = uint64(1)
+	blockHash := []byte("block-hash-under-test")
+
+	vals := newBlsValidators(t, 2, 50)
+	setupEpochValSet(t, ctx, k, epoch, vals)
+
+	outsider := newBlsValidators(t, 1, 0)[0]
+	// newBlsValidators numbers addresses from 1, so give the outsider an
+	// address that no member of vals has
+	outsider.addr = sdk.AccAddress([]byte{0xff})
+	err := k.AddBlsSig(ctx, addBlsSigMsg(t, outsider, epoch, blockHash))
+	require.ErrorIs(t, err, types.ErrInvalidBlsSig)
+}
@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/babylonchain/babylon/x/checkpointing/types"
+)
+
+// InitGenesis initializes the checkpointing module's in-flight BLS
+// signature aggregation state from a genesis state
+func (k Keeper) InitGenesis(ctx context.Context, gs types.GenesisState) error {
+	if err := gs.Validate(); err != nil {
+		return err
+	}
+
+	for _, sigSet := range gs.BlsSigSets {
+		sigSetCopy := sigSet
+		k.setBlsSigSet(ctx, &sigSetCopy)
+	}
+
+	return nil
+}
+
+// ExportGenesis returns the checkpointing module's in-flight BLS signature
+// aggregation state as a genesis state. In forZeroHeight mode, aggregations
+// that have not yet crossed the finalization threshold are dropped: they
+// refer to checkpoints for Babylon block hashes from a chain history that
+// is being collapsed away, and cannot be meaningfully resumed afterwards.
+func (k Keeper) ExportGenesis(ctx context.Context, forZeroHeight bool) (*types.GenesisState, error) {
+	gs := &types.GenesisState{}
+
+	k.IterateBlsSigSets(ctx, func(sigSet *types.BlsSigSet) bool {
+		if forZeroHeight && !sigSet.Finalized {
+			return true
+		}
+		gs.BlsSigSets = append(gs.BlsSigSets, *sigSet)
+		return true
+	})
+
+	return gs, nil
+}
@@ -0,0 +1,69 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/babylon/crypto/bls12381"
+	keepertest "github.com/babylonchain/babylon/testutil/keeper"
+	"github.com/babylonchain/babylon/x/checkpointing/types"
+)
+
+func newSigSet(epoch uint64, blockHash []byte, finalized bool) types.BlsSigSet {
+	return types.BlsSigSet{
+		Epoch:      epoch,
+		BlockHash:  blockHash,
+		AggrPk:     bls12381.InfinityPublicKey(),
+		AggrSig:    bls12381.InfinitySignature(),
+		Bitmap:     sdk.NewCompactBitArray(1),
+		TotalPower: 100,
+		Finalized:  finalized,
+	}
+}
+
+func TestCheckpointingExportGenesisThenInitGenesis(t *testing.T) {
+	k, ctx := keepertest.CheckpointingKeeper(t)
+
+	gs := types.GenesisState{
+		BlsSigSets: []types.BlsSigSet{
+			newSigSet(1, []byte("blockhash1"), true),
+			newSigSet(2, []byte("blockhash2"), false),
+		},
+	}
+	require.NoError(t, k.InitGenesis(ctx, gs))
+
+	exported, err := k.ExportGenesis(ctx, false)
+	require.NoError(t, err)
+	require.Len(t, exported.BlsSigSets, 2)
+
+	k2, ctx2 := keepertest.CheckpointingKeeper(t)
+	require.NoError(t, k2.InitGenesis(ctx2, *exported))
+
+	reExported, err := k2.ExportGenesis(ctx2, false)
+	require.NoError(t, err)
+	require.ElementsMatch(t, exported.BlsSigSets, reExported.BlsSigSets)
+}
+
+// TestCheckpointingExportGenesisForZeroHeightDropsUnfinalized verifies that
+// collapsing for a zero-height export drops in-flight aggregations that
+// never crossed the finalization threshold, since they reference Babylon
+// block hashes from chain history that is being collapsed away.
+func TestCheckpointingExportGenesisForZeroHeightDropsUnfinalized(t *testing.T) {
+	k, ctx := keepertest.CheckpointingKeeper(t)
+
+	gs := types.GenesisState{
+		BlsSigSets: []types.BlsSigSet{
+			newSigSet(1, []byte("blockhash1"), true),
+			newSigSet(2, []byte("blockhash2"), false),
+		},
+	}
+	require.NoError(t, k.InitGenesis(ctx, gs))
+
+	exported, err := k.ExportGenesis(ctx, true)
+	require.NoError(t, err)
+	require.Len(t, exported.BlsSigSets, 1)
+	require.True(t, exported.BlsSigSets[0].Finalized)
+	require.Equal(t, uint64(1), exported.BlsSigSets[0].Epoch)
+}
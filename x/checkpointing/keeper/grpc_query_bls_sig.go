@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/babylonchain/babylon/x/checkpointing/types"
+)
+
+// BlsSigSet implements the Query/BlsSigSet gRPC method. It returns the
+// aggregated BLS signature and contributor bitmap for a checkpoint, letting
+// light clients verify finalization with a single pairing check instead of
+// fetching and checking every individual validator signature.
+func (k Keeper) BlsSigSet(c context.Context, req *types.QueryBlsSigSetRequest) (*types.QueryBlsSigSetResponse, error) {
+	sigSet := k.GetBlsSigSet(c, req.Epoch, req.BlockHash)
+	if sigSet == nil {
+		return nil, types.ErrInvalidBlsSig.Wrapf("no BLS signature submitted yet for epoch %d", req.Epoch)
+	}
+
+	bitmapBz, err := sigSet.Bitmap.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryBlsSigSetResponse{
+		AggrPk:     sigSet.AggrPk,
+		AggrSig:    sigSet.AggrSig,
+		Bitmap:     bitmapBz,
+		TotalPower: sigSet.TotalPower,
+		Finalized:  sigSet.Finalized,
+	}, nil
+}
@@ -0,0 +1,30 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/babylonchain/babylon/crypto/bls12381"
+)
+
+// BlsSigSet tracks the in-progress aggregation of BLS signatures over a
+// single (Epoch, BlockHash) checkpoint. It accumulates one validator's
+// contribution at a time via point addition on G1 (public keys) and G2
+// (signatures), so that a finalized checkpoint can be verified with a
+// single pairing check against AggrPk instead of one check per validator.
+type BlsSigSet struct {
+	Epoch uint64
+	// BlockHash is the Babylon block hash the checkpoint commits to
+	BlockHash []byte
+	// AggrPk is the running aggregate of contributing validators' BLS public keys
+	AggrPk bls12381.PublicKey
+	// AggrSig is the running aggregate of contributing validators' BLS signatures
+	AggrSig bls12381.Signature
+	// Bitmap records which validators (by index in the epoch's BLS validator
+	// set) have contributed to AggrPk/AggrSig
+	Bitmap *sdk.CompactBitArray
+	// TotalPower is the summed voting power, at Epoch, of validators that
+	// have contributed so far
+	TotalPower int64
+	// Finalized is set once TotalPower first crossed the 2/3+ threshold
+	Finalized bool
+}
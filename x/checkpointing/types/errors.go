@@ -0,0 +1,16 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+)
+
+var (
+	// ErrInvalidBlsSig is returned when a BLS signature fails well-formedness
+	// checks (wrong length) or fails the pairing check against the signer's
+	// registered BLS public key
+	ErrInvalidBlsSig = sdkerrors.Register(ModuleName, 1200, "invalid BLS signature")
+
+	// ErrDuplicateBlsSig is returned when a validator submits more than one
+	// BLS signature for the same (epoch, blockHash)
+	ErrDuplicateBlsSig = sdkerrors.Register(ModuleName, 1201, "validator already submitted a BLS signature for this epoch and block hash")
+)
@@ -0,0 +1,12 @@
+package types
+
+// checkpointing module event types and attribute keys
+const (
+	EventTypeFinalizedCheckpoint = "finalized_checkpoint"
+
+	AttributeKeyEpoch     = "epoch"
+	AttributeKeyBlockHash = "block_hash"
+	AttributeKeyAggrPk    = "aggr_pk"
+	AttributeKeyAggrSig   = "aggr_sig"
+	AttributeKeyBitmap    = "bitmap"
+)
@@ -0,0 +1,30 @@
+package types
+
+import "fmt"
+
+// GenesisState defines the checkpointing module's genesis state
+type GenesisState struct {
+	// BlsSigSets holds every finalized (and, unless collapsed for a
+	// zero-height export, in-flight) BLS signature aggregation still
+	// present in the store at export time
+	BlsSigSets []BlsSigSet
+}
+
+// DefaultGenesis returns the default checkpointing genesis state
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{}
+}
+
+// Validate performs basic genesis state validation, returning an error upon
+// any failure
+func (gs GenesisState) Validate() error {
+	seen := map[string]bool{}
+	for _, sigSet := range gs.BlsSigSets {
+		key := fmt.Sprintf("%d:%x", sigSet.Epoch, sigSet.BlockHash)
+		if seen[key] {
+			return fmt.Errorf("duplicate BLS signature set for epoch %d, block hash %x", sigSet.Epoch, sigSet.BlockHash)
+		}
+		seen[key] = true
+	}
+	return nil
+}
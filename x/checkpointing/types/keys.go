@@ -0,0 +1,16 @@
+package types
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "checkpointing"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+)
+
+var (
+	// BlsSigSetKey is the key prefix under which the in-progress BLS
+	// signature aggregation for a checkpoint is stored:
+	// (BlsSigSetKey || epoch || blockHash) -> BlsSigSet
+	BlsSigSetKey = []byte{0x01}
+)
@@ -1,6 +1,10 @@
 package types
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/babylonchain/babylon/crypto/bls12381"
+)
 
 var (
 	// Ensure that MsgInsertHeader implements all functions of the Msg interface
@@ -14,7 +18,18 @@ func (m *MsgAddBlsSig) ValidateBasic() error {
 		return err
 	}
 
-	// TODO: verify bls sig
+	if len(m.BlsSig.BlockHash) == 0 {
+		return ErrInvalidBlsSig.Wrap("block hash is empty")
+	}
+
+	// Only well-formedness can be checked here: verifying the signature
+	// against the signer's registered BLS public key requires looking it up
+	// by (epoch, signer address), which is state the keeper has access to
+	// but ValidateBasic, being stateless, does not. The cryptographic check
+	// happens in Keeper.AddBlsSig.
+	if len(m.BlsSig.BlsSig) != bls12381.SignatureSize {
+		return ErrInvalidBlsSig.Wrapf("expected a %d-byte BLS signature, got %d bytes", bls12381.SignatureSize, len(m.BlsSig.BlsSig))
+	}
 
 	return nil
 }